@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AWSFargateProfileSpec defines the desired state of an EKS Fargate profile.
+type AWSFargateProfileSpec struct {
+	// AdditionalTags is an optional set of tags to add to AWS resources managed by the AWS provider, in addition to the ones added by default.
+	// +optional
+	AdditionalTags Tags `json:"additionalTags,omitempty"`
+}
+
+// AWSFargateProfileStatus defines the observed state of an EKS Fargate profile.
+type AWSFargateProfileStatus struct {
+	// Conditions captures the observed state of the Fargate profile's AWS resources.
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// AWSFargateProfile is the Schema for the awsfargateprofiles API.
+type AWSFargateProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSFargateProfileSpec   `json:"spec,omitempty"`
+	Status AWSFargateProfileStatus `json:"status,omitempty"`
+}
+
+// AWSFargateProfileList contains a list of AWSFargateProfile.
+type AWSFargateProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSFargateProfile `json:"items"`
+}
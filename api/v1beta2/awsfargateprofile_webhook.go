@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager registers the validating webhook for AWSFargateProfile.
+func (r *AWSFargateProfile) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-awsfargateprofile,mutating=false,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=awsfargateprofiles,versions=v1beta2,name=validation.awsfargateprofile.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+var _ webhook.Validator = &AWSFargateProfile{}
+
+// ValidateCreate rejects AdditionalTags keys that collide with a tag namespace this controller
+// manages for the Fargate profile's cluster.
+func (r *AWSFargateProfile) ValidateCreate() (admission.Warnings, error) {
+	return nil, r.validateTags()
+}
+
+// ValidateUpdate re-runs the same reserved-tag-key checks as ValidateCreate.
+func (r *AWSFargateProfile) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, r.validateTags()
+}
+
+// ValidateDelete implements webhook.Validator but imposes no delete-time constraints.
+func (r *AWSFargateProfile) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (r *AWSFargateProfile) validateTags() error {
+	clusterName := r.Labels[clusterNameLabel]
+
+	allErrs := ValidateAdditionalTags(clusterName, r.Spec.AdditionalTags, field.NewPath("spec", "additionalTags"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "AWSFargateProfile"}, r.Name, allErrs)
+}
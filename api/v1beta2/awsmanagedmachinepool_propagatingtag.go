@@ -0,0 +1,36 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+// PropagatingTag is a tag to apply to a nodegroup's AutoScalingGroup, with
+// explicit control over whether it propagates to the EC2 instances and EBS
+// volumes the ASG launches. Unlike AdditionalTags, which always propagates,
+// this lets users keep a label out of the EC2/EBS tag namespace (which has
+// its own 50-tag ceiling) while still exposing it on the ASG itself.
+type PropagatingTag struct {
+	// Key is the tag key.
+	Key string `json:"key"`
+
+	// Value is the tag value.
+	Value string `json:"value"`
+
+	// PropagateAtLaunch controls whether the tag is copied onto instances and
+	// EBS volumes launched by the ASG. Defaults to true if unset, matching the
+	// behaviour of AdditionalTags.
+	// +optional
+	PropagateAtLaunch *bool `json:"propagateAtLaunch,omitempty"`
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ResourceAdvertisements declares capacity, labels and taints that the
+// cluster-autoscaler would otherwise only learn about by inspecting a running
+// node. The controller writes these as k8s.io/cluster-autoscaler/node-template
+// tags on the managed nodegroup (and, with PropagateAtLaunch=false, on the
+// backing AutoScalingGroup) so that the autoscaler can size a scale-from-zero
+// nodegroup correctly for GPUs and other extended resources.
+type ResourceAdvertisements struct {
+	// Resources advertises extended/allocatable resources the nodegroup's
+	// instances provide, for example "nvidia.com/gpu" or "vpc.amazonaws.com/pod-eni".
+	// Keys are written as k8s.io/cluster-autoscaler/node-template/resources/<name> tags.
+	// +optional
+	Resources map[string]resource.Quantity `json:"resources,omitempty"`
+
+	// Labels advertises node labels the cluster-autoscaler should assume a
+	// node from this nodegroup will have before it exists.
+	// Keys are written as k8s.io/cluster-autoscaler/node-template/label/<key> tags.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints advertises node taints the cluster-autoscaler should assume a
+	// node from this nodegroup will have before it exists.
+	// Keys are written as k8s.io/cluster-autoscaler/node-template/taint/<key> tags.
+	// +optional
+	Taints []ResourceAdvertisementTaint `json:"taints,omitempty"`
+}
+
+// ResourceAdvertisementTaint is a node taint advertised ahead of time for an
+// as-yet-nonexistent node, in the `value:effect` form the cluster-autoscaler
+// node-template tag expects.
+type ResourceAdvertisementTaint struct {
+	// Key is the taint key.
+	Key string `json:"key"`
+	// Value is the taint value.
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Effect is the taint effect, e.g. NoSchedule.
+	Effect string `json:"effect"`
+}
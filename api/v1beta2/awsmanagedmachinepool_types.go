@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Tags defines a map of tags.
+type Tags map[string]string
+
+// AWSManagedMachinePoolSpec defines the desired state of an EKS managed nodegroup.
+type AWSManagedMachinePoolSpec struct {
+	// AdditionalTags is an optional set of tags to add to AWS resources managed by the AWS provider, in addition to the ones added by default.
+	// +optional
+	AdditionalTags Tags `json:"additionalTags,omitempty"`
+
+	// AdditionalASGTags are additional tags to apply to the nodegroup's underlying AutoScalingGroup, with
+	// explicit per-tag control over whether each one propagates to the EC2 instances and EBS volumes the
+	// AutoScalingGroup launches.
+	// +optional
+	AdditionalASGTags []PropagatingTag `json:"additionalASGTags,omitempty"`
+
+	// ResourceAdvertisements declares capacity, labels and taints the cluster-autoscaler should assume nodes
+	// from this nodegroup will have, letting it scale the nodegroup from zero without inspecting a live node.
+	// +optional
+	ResourceAdvertisements *ResourceAdvertisements `json:"resourceAdvertisements,omitempty"`
+}
+
+// AWSManagedMachinePoolStatus defines the observed state of an EKS managed nodegroup.
+type AWSManagedMachinePoolStatus struct {
+	// Conditions captures the observed state of the managed machine pool's AWS resources, such as whether
+	// tag reconciliation had to trim AdditionalTags to stay within an AWS-imposed tag limit.
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// Condition mirrors the subset of metav1.Condition the controller uses to record AWS-resource state that
+// isn't otherwise represented on AWSManagedMachinePoolStatus.
+type Condition struct {
+	Type               string      `json:"type"`
+	Status             string      `json:"status"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// TagLimitExceededReason is used on a Condition when tag reconciliation has to drop AdditionalTags to keep
+// a nodegroup's AutoScalingGroup, EC2 instances or EBS volumes within AWS's per-resource tag cap.
+const TagLimitExceededReason = "TagLimitExceeded"
+
+// AWSManagedMachinePool is the Schema for the awsmanagedmachinepools API.
+type AWSManagedMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSManagedMachinePoolSpec   `json:"spec,omitempty"`
+	Status AWSManagedMachinePoolStatus `json:"status,omitempty"`
+}
+
+// AWSManagedMachinePoolList contains a list of AWSManagedMachinePool.
+type AWSManagedMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSManagedMachinePool `json:"items"`
+}
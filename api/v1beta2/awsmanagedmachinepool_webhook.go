@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// clusterNameLabel is the label CAPI stamps on every infrastructure resource with the owning
+// Cluster's name; it's how the webhook knows which cluster's reserved tag namespace applies.
+const clusterNameLabel = "cluster.x-k8s.io/cluster-name"
+
+// SetupWebhookWithManager registers the validating webhook for AWSManagedMachinePool.
+func (m *AWSManagedMachinePool) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(m).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-awsmanagedmachinepool,mutating=false,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=awsmanagedmachinepools,versions=v1beta2,name=validation.awsmanagedmachinepool.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+var _ webhook.Validator = &AWSManagedMachinePool{}
+
+// ValidateCreate rejects AdditionalTags/AdditionalASGTags keys that collide with a tag namespace
+// this controller manages for the pool's cluster.
+func (m *AWSManagedMachinePool) ValidateCreate() (admission.Warnings, error) {
+	return nil, m.validateTags()
+}
+
+// ValidateUpdate re-runs the same reserved-tag-key checks as ValidateCreate.
+func (m *AWSManagedMachinePool) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, m.validateTags()
+}
+
+// ValidateDelete implements webhook.Validator but imposes no delete-time constraints.
+func (m *AWSManagedMachinePool) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (m *AWSManagedMachinePool) validateTags() error {
+	clusterName := m.Labels[clusterNameLabel]
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, ValidateAdditionalTags(clusterName, m.Spec.AdditionalTags, field.NewPath("spec", "additionalTags"))...)
+	allErrs = append(allErrs, ValidateAdditionalASGTags(clusterName, m.Spec.AdditionalASGTags, field.NewPath("spec", "additionalASGTags"))...)
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "AWSManagedMachinePool"}, m.Name, allErrs)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// reservedEKSTagKey mirrors pkg/cloud/services/eks.ReservedTagKey. It is
+// duplicated here, rather than imported, because api/v1beta2 sits below the
+// service layer in the import graph (pkg/cloud/services/eks already imports
+// this package), so the reconciler's source of truth can't be called
+// directly from a webhook. Keep the two lists in sync.
+func reservedEKSTagKey(clusterName, key string) bool {
+	if strings.HasPrefix(key, "sigs.k8s.io/cluster-api-provider-aws/") || strings.HasPrefix(key, "aws:") {
+		return true
+	}
+	if strings.HasPrefix(key, "k8s.io/cluster-autoscaler/node-template/") {
+		return true
+	}
+	switch key {
+	case "eks:cluster-name",
+		"eks:nodegroup-name",
+		"k8s.io/cluster-autoscaler/enabled",
+		fmt.Sprintf("k8s.io/cluster-autoscaler/%s", clusterName),
+		ClusterAWSCloudProviderTagKey(clusterName):
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateAdditionalTags rejects any AdditionalTags key that this controller
+// manages for clusterName, so a user can't shadow or fight the controller's
+// own tags: eks:cluster-name, eks:nodegroup-name,
+// k8s.io/cluster-autoscaler/enabled, k8s.io/cluster-autoscaler/<clusterName>,
+// kubernetes.io/cluster/<clusterName>, and any key under the
+// k8s.io/cluster-autoscaler/node-template/, sigs.k8s.io/cluster-api-provider-aws/
+// or aws: prefixes. Other keys under eks:, k8s.io/cluster-autoscaler/ or
+// kubernetes.io/cluster/ are accepted. Used by the AWSManagedControlPlane, AWSManagedMachinePool and
+// AWSFargateProfile validating webhooks.
+func ValidateAdditionalTags(clusterName string, tags map[string]string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for key := range tags {
+		if reservedEKSTagKey(clusterName, key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), key, "is a reserved tag key managed by the controller"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateAdditionalASGTags applies the same reserved-key check as
+// ValidateAdditionalTags to an AWSManagedMachinePool's AdditionalASGTags.
+func ValidateAdditionalASGTags(clusterName string, tags []PropagatingTag, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, tag := range tags {
+		if reservedEKSTagKey(clusterName, tag.Key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("key"), tag.Key, "is a reserved tag key managed by the controller"))
+		}
+	}
+	return allErrs
+}
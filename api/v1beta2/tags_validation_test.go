@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+func TestValidateAdditionalTagsRejectsReservedKeys(t *testing.T) {
+	const clusterName = "test-cluster"
+	tests := []struct {
+		key       string
+		wantError bool
+	}{
+		{key: "eks:cluster-name", wantError: true},
+		{key: "eks:nodegroup-name", wantError: true},
+		{key: "k8s.io/cluster-autoscaler/enabled", wantError: true},
+		{key: "k8s.io/cluster-autoscaler/test-cluster", wantError: true},
+		{key: "kubernetes.io/cluster/test-cluster", wantError: true},
+		{key: "sigs.k8s.io/cluster-api-provider-aws/role", wantError: true},
+		{key: "aws:anything", wantError: true},
+		{key: "team", wantError: false},
+		{key: "environment", wantError: false},
+	}
+
+	for _, tt := range tests {
+		errs := ValidateAdditionalTags(clusterName, map[string]string{tt.key: "v"}, field.NewPath("spec", "additionalTags"))
+		if tt.wantError && len(errs) == 0 {
+			t.Errorf("key %q: expected a validation error, got none", tt.key)
+		}
+		if !tt.wantError && len(errs) != 0 {
+			t.Errorf("key %q: expected no validation error, got %v", tt.key, errs)
+		}
+	}
+}
+
+func TestValidateAdditionalASGTagsRejectsReservedKeys(t *testing.T) {
+	tags := []PropagatingTag{
+		{Key: "team", Value: "infra"},
+		{Key: "eks:cluster-name", Value: "should-be-rejected", PropagateAtLaunch: pointer.Bool(true)},
+	}
+
+	errs := ValidateAdditionalASGTags("test-cluster", tags, field.NewPath("spec", "additionalASGTags"))
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors, want exactly 1 for the single reserved key: %v", len(errs), errs)
+	}
+	if errs[0].Field != "spec.additionalASGTags[1].key" {
+		t.Errorf("error field = %q, want it to point at the reserved entry's key", errs[0].Field)
+	}
+}
+
+func TestControlPlaneWebhookRejectsReservedAdditionalTags(t *testing.T) {
+	cp := &AWSManagedControlPlane{}
+	cp.Labels = map[string]string{clusterNameLabel: "test-cluster"}
+	cp.Spec.AdditionalTags = Tags{"eks:cluster-name": "nope"}
+
+	if _, err := cp.ValidateCreate(); err == nil {
+		t.Fatal("expected ValidateCreate to reject a reserved AdditionalTags key")
+	}
+
+	cp.Spec.AdditionalTags = Tags{"team": "infra"}
+	if _, err := cp.ValidateCreate(); err != nil {
+		t.Fatalf("unexpected error for a non-reserved AdditionalTags key: %v", err)
+	}
+}
+
+func TestFargateProfileWebhookRejectsReservedAdditionalTags(t *testing.T) {
+	fp := &AWSFargateProfile{}
+	fp.Labels = map[string]string{clusterNameLabel: "test-cluster"}
+	fp.Spec.AdditionalTags = Tags{"aws:reserved": "nope"}
+
+	if _, err := fp.ValidateCreate(); err == nil {
+		t.Fatal("expected ValidateCreate to reject a reserved AdditionalTags key")
+	}
+
+	fp.Spec.AdditionalTags = Tags{"team": "infra"}
+	if _, err := fp.ValidateCreate(); err != nil {
+		t.Fatalf("unexpected error for a non-reserved AdditionalTags key: %v", err)
+	}
+}
+
+func TestManagedMachinePoolWebhookRejectsReservedAdditionalASGTags(t *testing.T) {
+	mp := &AWSManagedMachinePool{}
+	mp.Labels = map[string]string{clusterNameLabel: "test-cluster"}
+	mp.Spec.AdditionalASGTags = []PropagatingTag{{Key: "k8s.io/cluster-autoscaler/enabled", Value: "false"}}
+
+	if _, err := mp.ValidateCreate(); err == nil {
+		t.Fatal("expected ValidateCreate to reject a reserved AdditionalASGTags key")
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// NodegroupScope is the subset of a nodegroup reconcile scope that
+// pkg/cloud/services/eks's tag reconciliation depends on. The concrete scope
+// used by the nodegroup reconciler must satisfy this interface.
+type NodegroupScope interface {
+	ClusterName() string
+	KubernetesClusterName() string
+	// AdditionalTags returns a fresh copy of the user-supplied AdditionalTags on every call;
+	// callers are free to mutate the returned map without affecting the scope or each other.
+	AdditionalTags() map[string]string
+	AdditionalASGTags() []infrav1.PropagatingTag
+	ResourceAdvertisements() infrav1.ResourceAdvertisements
+	RecordTagLimitExceeded(resource string, dropped []string)
+	Info(msg string, keysAndValues ...interface{})
+	Debug(msg string, keysAndValues ...interface{})
+}
+
+// tagsReconciledConditionType is the Condition.Type RecordTagLimitExceeded reports under; kept as a
+// single well-known type so repeated calls update the one condition in place instead of appending.
+const tagsReconciledConditionType = "TagsReconciled"
+
+// ManagedMachinePoolScope implements NodegroupScope for an AWSManagedMachinePool.
+type ManagedMachinePoolScope struct {
+	Logger      logr.Logger
+	Cluster     string
+	MachinePool *infrav1.AWSManagedMachinePool
+}
+
+// ClusterName returns the name of the Kubernetes cluster the nodegroup belongs to.
+func (s *ManagedMachinePoolScope) ClusterName() string {
+	return s.Cluster
+}
+
+// KubernetesClusterName returns the name used to tag the cluster's AWS resources.
+func (s *ManagedMachinePoolScope) KubernetesClusterName() string {
+	return s.Cluster
+}
+
+// AdditionalTags returns a copy of the user-supplied AdditionalTags, so callers are free to mutate it.
+func (s *ManagedMachinePoolScope) AdditionalTags() map[string]string {
+	tags := make(map[string]string, len(s.MachinePool.Spec.AdditionalTags))
+	for k, v := range s.MachinePool.Spec.AdditionalTags {
+		tags[k] = v
+	}
+	return tags
+}
+
+// AdditionalASGTags returns the nodegroup's per-tag PropagateAtLaunch overrides for its AutoScalingGroup.
+func (s *ManagedMachinePoolScope) AdditionalASGTags() []infrav1.PropagatingTag {
+	return s.MachinePool.Spec.AdditionalASGTags
+}
+
+// ResourceAdvertisements returns the nodegroup's cluster-autoscaler scale-from-zero advertisements.
+func (s *ManagedMachinePoolScope) ResourceAdvertisements() infrav1.ResourceAdvertisements {
+	if s.MachinePool.Spec.ResourceAdvertisements == nil {
+		return infrav1.ResourceAdvertisements{}
+	}
+	return *s.MachinePool.Spec.ResourceAdvertisements
+}
+
+// RecordTagLimitExceeded records, as a status condition on the AWSManagedMachinePool, that tag
+// reconciliation had to drop AdditionalTags to keep resource within an AWS-imposed tag limit.
+func (s *ManagedMachinePoolScope) RecordTagLimitExceeded(resource string, dropped []string) {
+	msg := fmt.Sprintf("dropped %d AdditionalTags on %s to stay within the AWS tag limit: %s", len(dropped), resource, strings.Join(dropped, ", "))
+	s.setTagsReconciledCondition("False", infrav1.TagLimitExceededReason, msg)
+	s.Info(msg)
+}
+
+// setTagsReconciledCondition finds the existing tagsReconciledConditionType condition and updates it
+// in place, or appends a new one if none exists yet. Tag reconciliation calls this once per over-cap
+// ASG/instance/volume, every reconcile, so without find-and-update Status.Conditions would grow
+// without bound instead of holding the usual one entry per condition type.
+func (s *ManagedMachinePoolScope) setTagsReconciledCondition(status, reason, message string) {
+	for i := range s.MachinePool.Status.Conditions {
+		c := &s.MachinePool.Status.Conditions[i]
+		if c.Type != tagsReconciledConditionType {
+			continue
+		}
+		if c.Status != status {
+			c.LastTransitionTime = metav1.Now()
+		}
+		c.Status = status
+		c.Reason = reason
+		c.Message = message
+		return
+	}
+	s.MachinePool.Status.Conditions = append(s.MachinePool.Status.Conditions, infrav1.Condition{
+		Type:               tagsReconciledConditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
+// Info logs a message at the default log level.
+func (s *ManagedMachinePoolScope) Info(msg string, keysAndValues ...interface{}) {
+	s.Logger.Info(msg, keysAndValues...)
+}
+
+// Debug logs a message at a verbose log level.
+func (s *ManagedMachinePoolScope) Debug(msg string, keysAndValues ...interface{}) {
+	s.Logger.V(1).Info(msg, keysAndValues...)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// TestRecordTagLimitExceededUpdatesInPlace locks in the fix for unbounded
+// Status.Conditions growth: repeated calls - as happen once per over-cap
+// instance/volume/ASG, every reconcile - must update the single
+// "TagsReconciled" condition in place, not append a new one each time.
+func TestRecordTagLimitExceededUpdatesInPlace(t *testing.T) {
+	s := &ManagedMachinePoolScope{
+		Logger:      logr.Discard(),
+		Cluster:     "test-cluster",
+		MachinePool: &infrav1.AWSManagedMachinePool{},
+	}
+
+	s.RecordTagLimitExceeded("Instance i-1", []string{"team"})
+	s.RecordTagLimitExceeded("Instance i-2", []string{"owner"})
+	s.RecordTagLimitExceeded("AutoScalingGroup asg-1", []string{"team", "owner"})
+
+	conditions := s.MachinePool.Status.Conditions
+	if len(conditions) != 1 {
+		t.Fatalf("expected exactly one condition after 3 calls, got %d: %+v", len(conditions), conditions)
+	}
+
+	got := conditions[0]
+	if got.Type != tagsReconciledConditionType {
+		t.Errorf("condition Type = %q, want %q", got.Type, tagsReconciledConditionType)
+	}
+	if got.Reason != infrav1.TagLimitExceededReason {
+		t.Errorf("condition Reason = %q, want %q", got.Reason, infrav1.TagLimitExceededReason)
+	}
+	for _, want := range []string{"AutoScalingGroup asg-1", "team", "owner"} {
+		if !strings.Contains(got.Message, want) {
+			t.Errorf("condition Message = %q, want it to contain %q (the most recent call)", got.Message, want)
+		}
+	}
+}
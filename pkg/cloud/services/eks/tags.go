@@ -18,6 +18,7 @@ package eks
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -37,8 +38,93 @@ const (
 	eksClusterNameTag              = "eks:cluster-name"
 	eksNodeGroupNameTag            = "eks:nodegroup-name"
 	eksClusterAutoscalerEnabledTag = "k8s.io/cluster-autoscaler/enabled"
+
+	// clusterAutoscalerNodeTemplateTagPrefix namespaces the tags used to
+	// advertise a nodegroup's ResourceAdvertisements to the cluster-autoscaler,
+	// so it can size a scale-from-zero nodegroup without inspecting a live node.
+	clusterAutoscalerNodeTemplateTagPrefix = "k8s.io/cluster-autoscaler/node-template/"
+
+	capaTagPrefix = "sigs.k8s.io/cluster-api-provider-aws/"
+	awsTagPrefix  = "aws:"
 )
 
+// ReservedTagKey reports whether key belongs to one of the tag namespaces this
+// controller manages for clusterName: the EKS/cluster-autoscaler/cloud-provider
+// tags reconciled above, the sigs.k8s.io/cluster-api-provider-aws/ and aws:
+// prefixes, AWS's own kubernetes.io/cluster/<name> tag, and the cluster-autoscaler
+// node-template namespace used by ResourceAdvertisements. getASGTagUpdates consults
+// it so it never deletes a reserved tag as drift - except for the node-template
+// namespace, which it only protects while the current reconcile's
+// ResourceAdvertisements still produces the key, since a stale scale-from-zero
+// advertisement must still be cleanable once removed from the spec. api/v1beta2's
+// validating webhook cannot call this directly - api/v1beta2 sits below this
+// package in the import graph - so api/v1beta2.reservedEKSTagKey mirrors this
+// list instead; keep the two in sync when either changes.
+func ReservedTagKey(clusterName, key string) bool {
+	if strings.HasPrefix(key, capaTagPrefix) || strings.HasPrefix(key, awsTagPrefix) {
+		return true
+	}
+	if strings.HasPrefix(key, clusterAutoscalerNodeTemplateTagPrefix) {
+		return true
+	}
+	for _, reserved := range reservedASGTagKeys(clusterName) {
+		if key == reserved {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceAdvertisementTags renders a nodegroup's ResourceAdvertisements as the
+// k8s.io/cluster-autoscaler/node-template tags the upstream cluster-autoscaler
+// reads to scale an EKS managed nodegroup from zero.
+func resourceAdvertisementTags(ra infrav1.ResourceAdvertisements) map[string]string {
+	out := make(map[string]string)
+	for name, qty := range ra.Resources {
+		out[clusterAutoscalerNodeTemplateTagPrefix+"resources/"+name] = qty.String()
+	}
+	for key, value := range ra.Labels {
+		out[clusterAutoscalerNodeTemplateTagPrefix+"label/"+key] = value
+	}
+	for _, taint := range ra.Taints {
+		out[clusterAutoscalerNodeTemplateTagPrefix+"taint/"+taint.Key] = fmt.Sprintf("%s:%s", taint.Value, taint.Effect)
+	}
+	return out
+}
+
+// reservedASGTagKeys returns the controller-managed ASG tag keys for a
+// cluster, so reserved tags can be given priority over AdditionalTags when
+// checking the AWS tag cap.
+func reservedASGTagKeys(clusterName string) []string {
+	return []string{
+		eksClusterNameTag,
+		eksNodeGroupNameTag,
+		fmt.Sprintf("k8s.io/cluster-autoscaler/%s", clusterName),
+		eksClusterAutoscalerEnabledTag,
+		infrav1.ClusterAWSCloudProviderTagKey(clusterName),
+	}
+}
+
+// splitReserved partitions tags into the subset whose keys are in reservedKeys
+// and the remainder, so callers can validate and prioritise reserved tags
+// separately from user-supplied AdditionalTags.
+func splitReserved(tags map[string]string, reservedKeys []string) (reserved, additional map[string]string) {
+	reservedSet := make(map[string]struct{}, len(reservedKeys))
+	for _, k := range reservedKeys {
+		reservedSet[k] = struct{}{}
+	}
+	reserved = make(map[string]string)
+	additional = make(map[string]string)
+	for k, v := range tags {
+		if _, ok := reservedSet[k]; ok {
+			reserved[k] = v
+		} else {
+			additional[k] = v
+		}
+	}
+	return reserved, additional
+}
+
 func (s *Service) reconcileTags(cluster *eks.Cluster) error {
 	clusterTags := converters.MapPtrToMap(cluster.Tags)
 	buildParams := s.getEKSTagParams(*cluster.Arn)
@@ -80,28 +166,22 @@ func getTagUpdates(currentTags map[string]string, tags map[string]string) (untag
 }
 
 func getASGTagUpdates(clusterName string, currentTags map[string]string, tags map[string]string) (tagsToDelete map[string]string, tagsToAdd map[string]string) {
-	officialASGTagsByEKS := []string{
-		eksClusterNameTag,
-		eksNodeGroupNameTag,
-		fmt.Sprintf("k8s.io/cluster-autoscaler/%s", clusterName),
-		eksClusterAutoscalerEnabledTag,
-		infrav1.ClusterAWSCloudProviderTagKey(clusterName),
-	}
 	tagsToDelete = make(map[string]string)
 	tagsToAdd = make(map[string]string)
 	for k, v := range currentTags {
-		if _, ok := tags[k]; !ok {
-			isOfficialTag := false
-			for _, tag := range officialASGTagsByEKS {
-				if tag == k {
-					isOfficialTag = true
-					break
-				}
-			}
-			if !isOfficialTag {
-				tagsToDelete[k] = v
-			}
+		if _, ok := tags[k]; ok {
+			continue
+		}
+		// A node-template key is only reserved from deletion for as long as the
+		// current reconcile's ResourceAdvertisements still produces it (i.e. it's
+		// present in tags, handled above); once it's removed there - the user
+		// shrank or cleared ResourceAdvertisements - it must be deletable like
+		// any other tag, or a stale scale-from-zero advertisement becomes
+		// permanent garbage on the ASG.
+		if ReservedTagKey(clusterName, k) && !strings.HasPrefix(k, clusterAutoscalerNodeTemplateTagPrefix) {
+			continue
 		}
+		tagsToDelete[k] = v
 	}
 	for key, value := range tags {
 		if currentV, ok := currentTags[key]; !ok || value != currentV {
@@ -112,15 +192,24 @@ func getASGTagUpdates(clusterName string, currentTags map[string]string, tags ma
 }
 
 func (s *NodegroupService) reconcileTags(ng *eks.Nodegroup) error {
-	tags := ngTags(s.scope.ClusterName(), s.scope.AdditionalTags())
-	if err := updateTags(s.EKSClient, ng.NodegroupArn, aws.StringValueMap(ng.Tags), tags); err != nil {
+	nodegroupTags := ngTags(s.scope.ClusterName(), s.scope.AdditionalTags())
+	for k, v := range resourceAdvertisementTags(s.scope.ResourceAdvertisements()) {
+		nodegroupTags[k] = v
+	}
+	if err := updateTags(s.EKSClient, ng.NodegroupArn, aws.StringValueMap(ng.Tags), nodegroupTags); err != nil {
 		return err
 	}
 	return s.reconcileInstanceTags(ng)
 }
 
+// instanceTagConcurrency bounds how many instances' (and their volumes')
+// tags are reconciled at once, so a large nodegroup doesn't serialize one
+// EC2 call per instance.
+const instanceTagConcurrency = 8
+
 func (s *NodegroupService) reconcileInstanceTags(ng *eks.Nodegroup) error {
 	ngtags := ngTags(s.scope.ClusterName(), s.scope.AdditionalTags())
+	staleKeys := nonPropagatingASGTagKeys(s.scope.AdditionalASGTags())
 	groupReq := autoscaling.DescribeAutoScalingGroupsInput{}
 	for _, asg := range ng.Resources.AutoScalingGroups {
 		groupReq.AutoScalingGroupNames = append(groupReq.AutoScalingGroupNames, asg.Name)
@@ -136,76 +225,144 @@ func (s *NodegroupService) reconcileInstanceTags(ng *eks.Nodegroup) error {
 		}
 	}
 	s.scope.Info("instances of autoscaling groups", "count", len(ids), "service", "tags:NodegroupService")
+
+	var instances []*ec2.Instance
 	ec2Req := ec2.DescribeInstancesInput{InstanceIds: ids}
-	output, err := s.EC2Client.DescribeInstances(&ec2Req)
+	err = s.EC2Client.DescribeInstancesPages(&ec2Req, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			instances = append(instances, reservation.Instances...)
+		}
+		return true
+	})
 	if err != nil {
 		return errors.Wrap(err, "failed to describe Instances")
 	}
 
-	for {
-		for _, reservation := range output.Reservations {
-			for _, instance := range reservation.Instances {
-				tags, desired := make(map[string]string), make(map[string]string)
-				for _, tag := range instance.Tags {
-					if tag != nil && tag.Key != nil && tag.Value != nil {
-						tags[*tag.Key] = *tag.Value
-						desired[*tag.Key] = *tag.Value
-					}
-				}
-				for k, v := range ngtags {
-					desired[k] = v
-				}
-				s.scope.Info("updating instance tag", "instance", instance.InstanceId)
-				if err = updateECSTags(s.EC2Client, []*string{instance.InstanceId}, tags, desired); err != nil {
-					return err
-				}
-				volumeIds := make([]*string, 0)
-				for _, b := range instance.BlockDeviceMappings {
-					if b != nil && b.Ebs != nil && b.Ebs.VolumeId != nil {
-						volumeIds = append(volumeIds, b.Ebs.VolumeId)
-					}
-				}
-				if err = s.reconcileEBSVolumeTags(volumeIds, ng); err != nil {
-					return err
-				}
+	return runBounded(len(instances), instanceTagConcurrency, func(i int) error {
+		instance := instances[i]
+		existingTags, desired := make(map[string]string), make(map[string]string)
+		for _, tag := range instance.Tags {
+			if tag != nil && tag.Key != nil && tag.Value != nil {
+				existingTags[*tag.Key] = *tag.Value
+				desired[*tag.Key] = *tag.Value
 			}
 		}
-		if output.NextToken == nil {
-			break
+		for k, v := range ngtags {
+			desired[k] = v
 		}
-	}
-	return nil
+		for _, k := range staleKeys {
+			delete(desired, k)
+		}
+		// reconcileInstanceTags/reconcileEBSVolumeTags enforce the tag cap here, before
+		// calling updateECSTags: that helper is a dumb apply step with no notion of
+		// which keys are reserved, so it can't decide what to trim.
+		instanceResource := fmt.Sprintf("Instance %s", aws.StringValue(instance.InstanceId))
+		reserved, additional := splitReserved(desired, reservedASGTagKeys(s.scope.ClusterName()))
+		kept, dropped, limitErr := tags.TrimToLimit(instanceResource, tags.MaxResourceTags, reserved, additional)
+		if len(dropped) > 0 {
+			s.scope.RecordTagLimitExceeded(instanceResource, dropped)
+		}
+		if limitErr != nil {
+			return errors.Wrapf(limitErr, "instance %s", aws.StringValue(instance.InstanceId))
+		}
+		desired = kept
+		s.scope.Info("updating instance tag", "instance", instance.InstanceId)
+		if err := updateECSTags(s.EC2Client, []*string{instance.InstanceId}, existingTags, desired); err != nil {
+			return errors.Wrapf(err, "instance %s", aws.StringValue(instance.InstanceId))
+		}
+		volumeIds := make([]*string, 0)
+		for _, b := range instance.BlockDeviceMappings {
+			if b != nil && b.Ebs != nil && b.Ebs.VolumeId != nil {
+				volumeIds = append(volumeIds, b.Ebs.VolumeId)
+			}
+		}
+		return s.reconcileEBSVolumeTags(volumeIds, ng)
+	})
 }
 
 func (s *NodegroupService) reconcileEBSVolumeTags(volumeIds []*string, ng *eks.Nodegroup) error {
+	if len(volumeIds) == 0 {
+		return nil
+	}
 	ngtags := ngTags(s.scope.ClusterName(), s.scope.AdditionalTags())
+	staleKeys := nonPropagatingASGTagKeys(s.scope.AdditionalASGTags())
+
+	var volumes []*ec2.Volume
 	req := ec2.DescribeVolumesInput{VolumeIds: volumeIds}
-	output, err := s.EC2Client.DescribeVolumes(&req)
+	err := s.EC2Client.DescribeVolumesPages(&req, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+		volumes = append(volumes, page.Volumes...)
+		return true
+	})
 	if err != nil {
 		return errors.Wrap(err, "failed to describe Volumes")
 	}
-	for {
-		for _, volume := range output.Volumes {
-			tags, desired := make(map[string]string), make(map[string]string)
-			desired[eksClusterNameTag] = s.scope.ClusterName()
-			desired[eksNodeGroupNameTag] = *ng.NodegroupName
-			for _, tag := range volume.Tags {
-				if tag != nil && tag.Key != nil && tag.Value != nil {
-					tags[*tag.Key] = *tag.Value
-					desired[*tag.Key] = *tag.Value
-				}
-			}
-			for k, v := range ngtags {
-				desired[k] = v
-			}
-			if err = updateECSTags(s.EC2Client, []*string{volume.VolumeId}, tags, desired); err != nil {
-				return err
+
+	return runBounded(len(volumes), instanceTagConcurrency, func(i int) error {
+		volume := volumes[i]
+		existingTags, desired := make(map[string]string), make(map[string]string)
+		desired[eksClusterNameTag] = s.scope.ClusterName()
+		desired[eksNodeGroupNameTag] = *ng.NodegroupName
+		for _, tag := range volume.Tags {
+			if tag != nil && tag.Key != nil && tag.Value != nil {
+				existingTags[*tag.Key] = *tag.Value
+				desired[*tag.Key] = *tag.Value
 			}
 		}
-		if output.NextToken == nil {
-			break
+		for k, v := range ngtags {
+			desired[k] = v
+		}
+		for _, k := range staleKeys {
+			delete(desired, k)
+		}
+		volumeResource := fmt.Sprintf("Volume %s", aws.StringValue(volume.VolumeId))
+		reserved, additional := splitReserved(desired, reservedASGTagKeys(s.scope.ClusterName()))
+		kept, dropped, limitErr := tags.TrimToLimit(volumeResource, tags.MaxResourceTags, reserved, additional)
+		if len(dropped) > 0 {
+			s.scope.RecordTagLimitExceeded(volumeResource, dropped)
+		}
+		if limitErr != nil {
+			return errors.Wrapf(limitErr, "volume %s", aws.StringValue(volume.VolumeId))
+		}
+		desired = kept
+		if err := updateECSTags(s.EC2Client, []*string{volume.VolumeId}, existingTags, desired); err != nil {
+			return errors.Wrapf(err, "volume %s", aws.StringValue(volume.VolumeId))
+		}
+		return nil
+	})
+}
+
+// runBounded runs fn for indices 0..n-1 with at most concurrency calls in
+// flight at once, aggregating every error returned rather than stopping at
+// the first, so one throttled EC2 call doesn't abort tagging for the rest of
+// the nodegroup's instances/volumes.
+func runBounded(n, concurrency int, fn func(i int) error) error {
+	if n == 0 {
+		return nil
+	}
+	sem := make(chan struct{}, concurrency)
+	errCh := make(chan error, n)
+	for i := 0; i < n; i++ {
+		i := i
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem }()
+			errCh <- fn(i)
+		}()
+	}
+	for i := 0; i < concurrency; i++ {
+		sem <- struct{}{}
+	}
+	close(errCh)
+
+	var errMsgs []string
+	for err := range errCh {
+		if err != nil {
+			errMsgs = append(errMsgs, err.Error())
 		}
 	}
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("failed to reconcile tags for %d of %d resources: %s", len(errMsgs), n, strings.Join(errMsgs, "; "))
+	}
 	return nil
 }
 
@@ -217,6 +374,51 @@ func tagDescriptionsToMap(input []*autoscaling.TagDescription) map[string]string
 	return tags
 }
 
+// tagDescriptionsPropagateMap records the current PropagateAtLaunch setting
+// AWS reports for each ASG tag, so reconcileASGTags can tell a value-only
+// change apart from a propagation-only change.
+func tagDescriptionsPropagateMap(input []*autoscaling.TagDescription) map[string]bool {
+	propagate := make(map[string]bool, len(input))
+	for _, v := range input {
+		propagate[*v.Key] = aws.BoolValue(v.PropagateAtLaunch)
+	}
+	return propagate
+}
+
+// nonPropagatingASGTagKeys returns the AdditionalASGTags keys explicitly
+// marked PropagateAtLaunch=false, so reconcileInstanceTags/reconcileEBSVolumeTags
+// can strip any stale copy AWS propagated to an instance/volume before the
+// tag was flipped to non-propagating.
+func nonPropagatingASGTagKeys(additionalASGTags []infrav1.PropagatingTag) []string {
+	var keys []string
+	for _, tag := range additionalASGTags {
+		if tag.PropagateAtLaunch != nil && !*tag.PropagateAtLaunch {
+			keys = append(keys, tag.Key)
+		}
+	}
+	return keys
+}
+
+// asgPropagationOverrides merges a nodegroup's AdditionalASGTags into the
+// desired ASG tag value map and returns the PropagateAtLaunch each key should
+// have. Keys with no explicit override keep the caller's default (true for
+// plain AdditionalTags, false for resource-advertisement tags).
+func asgPropagationOverrides(additionalASGTags []infrav1.PropagatingTag, desired map[string]string, defaultPropagate map[string]bool) map[string]bool {
+	propagate := make(map[string]bool, len(desired))
+	for k := range desired {
+		propagate[k] = defaultPropagate[k]
+	}
+	for _, tag := range additionalASGTags {
+		desired[tag.Key] = tag.Value
+		if tag.PropagateAtLaunch != nil {
+			propagate[tag.Key] = *tag.PropagateAtLaunch
+		} else {
+			propagate[tag.Key] = true
+		}
+	}
+	return propagate
+}
+
 func (s *NodegroupService) reconcileASGTags(ng *eks.Nodegroup) error {
 	s.scope.Info("Reconciling ASG tags", "cluster-name", s.scope.ClusterName(), "nodegroup-name", *ng.NodegroupName)
 	asg, err := s.describeASGs(ng)
@@ -224,43 +426,100 @@ func (s *NodegroupService) reconcileASGTags(ng *eks.Nodegroup) error {
 		return errors.Wrap(err, "failed to describe ASG for nodegroup")
 	}
 
-	tagsToDelete, tagsToAdd := getASGTagUpdates(s.scope.ClusterName(), tagDescriptionsToMap(asg.Tags), s.scope.AdditionalTags())
+	// AdditionalTags() is documented to return a fresh copy, so it's safe to merge
+	// the synthetic resource-advertisement/ASG-only tags below directly into it.
+	desired := s.scope.AdditionalTags()
+	defaultPropagate := make(map[string]bool, len(desired))
+	for k := range desired {
+		defaultPropagate[k] = true
+	}
+	for k, v := range resourceAdvertisementTags(s.scope.ResourceAdvertisements()) {
+		desired[k] = v
+		// Resource-advertisement tags must never propagate to launched
+		// instances/volumes: they describe capacity the cluster-autoscaler
+		// expects a not-yet-existing node to have, not a real tag for it.
+		defaultPropagate[k] = false
+	}
+	desiredPropagate := asgPropagationOverrides(s.scope.AdditionalASGTags(), desired, defaultPropagate)
+
+	asgResource := fmt.Sprintf("AutoScalingGroup %s", aws.StringValue(asg.AutoScalingGroupName))
+	reservedKeys := reservedASGTagKeys(s.scope.ClusterName())
+	reserved, additional := splitReserved(desired, reservedKeys)
+	_, dropped, limitErr := tags.TrimToLimit(asgResource, tags.MaxResourceTags, reserved, additional)
+	if len(dropped) > 0 {
+		s.scope.RecordTagLimitExceeded(asgResource, dropped)
+		for _, k := range dropped {
+			delete(desired, k)
+			delete(desiredPropagate, k)
+		}
+	}
+	if limitErr != nil {
+		return limitErr
+	}
+
+	currentPropagate := tagDescriptionsPropagateMap(asg.Tags)
+	tagsToDelete, tagsToAdd := getASGTagUpdates(s.scope.ClusterName(), tagDescriptionsToMap(asg.Tags), desired)
+	// A tag whose value is unchanged but whose PropagateAtLaunch setting
+	// differs from what's on the ASG today still needs to be re-issued, or
+	// the new propagation setting never takes effect.
+	for key, value := range desired {
+		if _, changing := tagsToAdd[key]; changing {
+			continue
+		}
+		if currentPropagate[key] != desiredPropagate[key] {
+			tagsToAdd[key] = value
+		}
+	}
 	s.scope.Debug("Tags", "tagsToAdd", tagsToAdd, "tagsToDelete", tagsToDelete)
 
 	if len(tagsToAdd) > 0 {
-		input := &autoscaling.CreateOrUpdateTagsInput{}
-		for k, v := range tagsToAdd {
-			// The k/vCopy is used to address the "Implicit memory aliasing in for loop" issue
-			// https://stackoverflow.com/questions/62446118/implicit-memory-aliasing-in-for-loop
-			kCopy := k
-			vCopy := v
-			input.Tags = append(input.Tags, &autoscaling.Tag{
-				Key:               &kCopy,
-				PropagateAtLaunch: aws.Bool(true),
-				ResourceId:        asg.AutoScalingGroupName,
-				ResourceType:      pointer.String("auto-scaling-group"),
-				Value:             &vCopy,
-			})
-		}
-		_, err = s.AutoscalingClient.CreateOrUpdateTags(input)
+		keys := make([]string, 0, len(tagsToAdd))
+		for k := range tagsToAdd {
+			keys = append(keys, k)
+		}
+		err = tags.BatchMutate(keys, func(batch []string) error {
+			input := &autoscaling.CreateOrUpdateTagsInput{}
+			for _, k := range batch {
+				// The k/vCopy is used to address the "Implicit memory aliasing in for loop" issue
+				// https://stackoverflow.com/questions/62446118/implicit-memory-aliasing-in-for-loop
+				kCopy := k
+				vCopy := tagsToAdd[k]
+				input.Tags = append(input.Tags, &autoscaling.Tag{
+					Key:               &kCopy,
+					PropagateAtLaunch: aws.Bool(desiredPropagate[kCopy]),
+					ResourceId:        asg.AutoScalingGroupName,
+					ResourceType:      pointer.String("auto-scaling-group"),
+					Value:             &vCopy,
+				})
+			}
+			_, applyErr := s.AutoscalingClient.CreateOrUpdateTags(input)
+			return applyErr
+		})
 		if err != nil {
 			return errors.Wrap(err, "failed to add tags to nodegroup's AutoScalingGroup")
 		}
 	}
 
 	if len(tagsToDelete) > 0 {
-		input := &autoscaling.DeleteTagsInput{}
+		keys := make([]string, 0, len(tagsToDelete))
 		for k := range tagsToDelete {
-			// The k/vCopy is used to address the "Implicit memory aliasing in for loop" issue
-			// https://stackoverflow.com/questions/62446118/implicit-memory-aliasing-in-for-loop
-			kCopy := k
-			input.Tags = append(input.Tags, &autoscaling.Tag{
-				Key:          &kCopy,
-				ResourceId:   asg.AutoScalingGroupName,
-				ResourceType: pointer.String("auto-scaling-group"),
-			})
-		}
-		_, err = s.AutoscalingClient.DeleteTags(input)
+			keys = append(keys, k)
+		}
+		err = tags.BatchMutate(keys, func(batch []string) error {
+			input := &autoscaling.DeleteTagsInput{}
+			for _, k := range batch {
+				// The k/vCopy is used to address the "Implicit memory aliasing in for loop" issue
+				// https://stackoverflow.com/questions/62446118/implicit-memory-aliasing-in-for-loop
+				kCopy := k
+				input.Tags = append(input.Tags, &autoscaling.Tag{
+					Key:          &kCopy,
+					ResourceId:   asg.AutoScalingGroupName,
+					ResourceType: pointer.String("auto-scaling-group"),
+				})
+			}
+			_, applyErr := s.AutoscalingClient.DeleteTags(input)
+			return applyErr
+		})
 		if err != nil {
 			return errors.Wrap(err, "failed to delete tags to nodegroup's AutoScalingGroup")
 		}
@@ -278,22 +537,36 @@ func updateTags(client eksiface.EKSAPI, arn *string, existingTags, desiredTags m
 	untagKeys, newTags := getTagUpdates(existingTags, desiredTags)
 
 	if len(newTags) > 0 {
-		tagInput := &eks.TagResourceInput{
-			ResourceArn: arn,
-			Tags:        aws.StringMap(newTags),
+		newTagKeys := make([]string, 0, len(newTags))
+		for k := range newTags {
+			newTagKeys = append(newTagKeys, k)
 		}
-		_, err := client.TagResource(tagInput)
+		err := tags.BatchMutate(newTagKeys, func(batch []string) error {
+			batchTags := make(map[string]string, len(batch))
+			for _, k := range batch {
+				batchTags[k] = newTags[k]
+			}
+			tagInput := &eks.TagResourceInput{
+				ResourceArn: arn,
+				Tags:        aws.StringMap(batchTags),
+			}
+			_, applyErr := client.TagResource(tagInput)
+			return applyErr
+		})
 		if err != nil {
 			return err
 		}
 	}
 
 	if len(untagKeys) > 0 {
-		untagInput := &eks.UntagResourceInput{
-			ResourceArn: arn,
-			TagKeys:     aws.StringSlice(untagKeys),
-		}
-		_, err := client.UntagResource(untagInput)
+		err := tags.BatchMutate(untagKeys, func(batch []string) error {
+			untagInput := &eks.UntagResourceInput{
+				ResourceArn: arn,
+				TagKeys:     aws.StringSlice(batch),
+			}
+			_, applyErr := client.UntagResource(untagInput)
+			return applyErr
+		})
 		if err != nil {
 			return err
 		}
@@ -305,33 +578,45 @@ func updateTags(client eksiface.EKSAPI, arn *string, existingTags, desiredTags m
 func updateECSTags(client ec2iface.EC2API, resources []*string, existingTags, desiredTags map[string]string) error {
 	untagKeys, newTags := getTagUpdates(existingTags, desiredTags)
 	if len(newTags) > 0 {
-		tags := make([]*ec2.Tag, 0)
-		for k, v := range newTags {
-			tags = append(tags, &ec2.Tag{
-				Key:   &k,
-				Value: &v,
-			})
-		}
-		tagInput := &ec2.CreateTagsInput{
-			Tags:      tags,
-			Resources: resources,
-		}
-		_, err := client.CreateTags(tagInput)
+		newTagKeys := make([]string, 0, len(newTags))
+		for k := range newTags {
+			newTagKeys = append(newTagKeys, k)
+		}
+		err := tags.BatchMutate(newTagKeys, func(batch []string) error {
+			ec2Tags := make([]*ec2.Tag, 0, len(batch))
+			for _, k := range batch {
+				kCopy, vCopy := k, newTags[k]
+				ec2Tags = append(ec2Tags, &ec2.Tag{
+					Key:   &kCopy,
+					Value: &vCopy,
+				})
+			}
+			tagInput := &ec2.CreateTagsInput{
+				Tags:      ec2Tags,
+				Resources: resources,
+			}
+			_, applyErr := client.CreateTags(tagInput)
+			return applyErr
+		})
 		if err != nil {
 			return err
 		}
 	}
 
 	if len(untagKeys) > 0 {
-		tags := make([]*ec2.Tag, len(untagKeys))
-		for i, k := range untagKeys {
-			tags[i] = &ec2.Tag{Key: &k}
-		}
-		untagInput := &ec2.DeleteTagsInput{
-			Resources: resources,
-			Tags:      tags,
-		}
-		_, err := client.DeleteTags(untagInput)
+		err := tags.BatchMutate(untagKeys, func(batch []string) error {
+			ec2Tags := make([]*ec2.Tag, len(batch))
+			for i, k := range batch {
+				kCopy := k
+				ec2Tags[i] = &ec2.Tag{Key: &kCopy}
+			}
+			untagInput := &ec2.DeleteTagsInput{
+				Resources: resources,
+				Tags:      ec2Tags,
+			}
+			_, applyErr := client.DeleteTags(untagInput)
+			return applyErr
+		})
 		if err != nil {
 			return err
 		}
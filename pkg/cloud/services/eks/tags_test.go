@@ -0,0 +1,238 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/go-logr/logr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+// pagedEC2Client is a hand-written ec2iface.EC2API that serves
+// DescribeInstances/DescribeVolumes across two pages, so tests can assert the
+// reconciler visits every page instead of stopping after the first.
+type pagedEC2Client struct {
+	ec2iface.EC2API
+
+	instancePages []*ec2.DescribeInstancesOutput
+	volumePages   []*ec2.DescribeVolumesOutput
+
+	mu      sync.Mutex
+	tagged  []string
+	created int
+}
+
+func (c *pagedEC2Client) DescribeInstancesPages(_ *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+	for i, page := range c.instancePages {
+		if !fn(page, i == len(c.instancePages)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func (c *pagedEC2Client) DescribeVolumesPages(in *ec2.DescribeVolumesInput, fn func(*ec2.DescribeVolumesOutput, bool) bool) error {
+	// Like the real API, only return the volumes the caller asked for.
+	requested := make(map[string]bool, len(in.VolumeIds))
+	for _, id := range in.VolumeIds {
+		requested[aws.StringValue(id)] = true
+	}
+	for i, page := range c.volumePages {
+		filtered := &ec2.DescribeVolumesOutput{}
+		for _, v := range page.Volumes {
+			if requested[aws.StringValue(v.VolumeId)] {
+				filtered.Volumes = append(filtered.Volumes, v)
+			}
+		}
+		if !fn(filtered, i == len(c.volumePages)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func (c *pagedEC2Client) CreateTags(in *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.created++
+	c.tagged = append(c.tagged, aws.StringValueSlice(in.Resources)...)
+	return &ec2.CreateTagsOutput{}, nil
+}
+
+func (c *pagedEC2Client) DeleteTags(*ec2.DeleteTagsInput) (*ec2.DeleteTagsOutput, error) {
+	return &ec2.DeleteTagsOutput{}, nil
+}
+
+type noopAutoscalingClient struct {
+	autoscalingiface.AutoScalingAPI
+}
+
+func (noopAutoscalingClient) DescribeAutoScalingGroups(*autoscaling.DescribeAutoScalingGroupsInput) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	return &autoscaling.DescribeAutoScalingGroupsOutput{}, nil
+}
+
+func testScope() *scope.ManagedMachinePoolScope {
+	return &scope.ManagedMachinePoolScope{
+		Logger:      logr.Discard(),
+		Cluster:     "test-cluster",
+		MachinePool: &infrav1.AWSManagedMachinePool{},
+	}
+}
+
+func instanceWithVolume(instanceID, volumeID string) *ec2.Instance {
+	return &ec2.Instance{
+		InstanceId: aws.String(instanceID),
+		BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+			{Ebs: &ec2.EbsInstanceBlockDevice{VolumeId: aws.String(volumeID)}},
+		},
+	}
+}
+
+// TestReconcileInstanceTagsPaginatesAllPages locks in the DescribeInstancesPages
+// fix: a nodegroup whose instances span two DescribeInstances pages must have
+// every instance on both pages tagged, not just the first page's.
+func TestReconcileInstanceTagsPaginatesAllPages(t *testing.T) {
+	client := &pagedEC2Client{
+		instancePages: []*ec2.DescribeInstancesOutput{
+			{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{instanceWithVolume("i-page1", "vol-page1")}}}},
+			{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{instanceWithVolume("i-page2", "vol-page2")}}}},
+		},
+		volumePages: []*ec2.DescribeVolumesOutput{
+			{Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-page1")}}},
+			{Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-page2")}}},
+		},
+	}
+
+	s := &NodegroupService{
+		scope:             testScope(),
+		EC2Client:         client,
+		AutoscalingClient: noopAutoscalingClient{},
+	}
+
+	ng := &eks.Nodegroup{
+		NodegroupName: aws.String("test-nodegroup"),
+		Resources:     &eks.NodegroupResources{},
+	}
+
+	if err := s.reconcileInstanceTags(ng); err != nil {
+		t.Fatalf("reconcileInstanceTags returned an error: %v", err)
+	}
+
+	client.mu.Lock()
+	tagged := append([]string(nil), client.tagged...)
+	client.mu.Unlock()
+	sort.Strings(tagged)
+
+	want := []string{"i-page1", "i-page2", "vol-page1", "vol-page2"}
+	sort.Strings(want)
+	if len(tagged) != len(want) {
+		t.Fatalf("tagged %v, want every resource across both pages: %v", tagged, want)
+	}
+	for i, id := range want {
+		if tagged[i] != id {
+			t.Errorf("tagged %v, want %v (page 2 was dropped by the old pagination bug)", tagged, want)
+			break
+		}
+	}
+}
+
+// TestRunBoundedAggregatesErrors ensures one failing item doesn't stop the
+// rest of the bounded pool from running, and that every error is reported.
+func TestRunBoundedAggregatesErrors(t *testing.T) {
+	const n = 5
+	var ran int32
+	var mu sync.Mutex
+	err := runBounded(n, 2, func(i int) error {
+		mu.Lock()
+		ran++
+		mu.Unlock()
+		if i == 2 {
+			return errFixture
+		}
+		return nil
+	})
+	mu.Lock()
+	defer mu.Unlock()
+	if ran != n {
+		t.Fatalf("expected all %d items to run despite one failing, ran %d", n, ran)
+	}
+	if err == nil {
+		t.Fatal("expected an aggregated error, got nil")
+	}
+}
+
+var errFixture = &testError{"boom"}
+
+type testError struct{ msg string }
+
+func (e *testError) Error() string { return e.msg }
+
+// TestGetASGTagUpdatesDropsStaleNodeTemplateTag locks in the fix for a stale
+// scale-from-zero advertisement tag: once a node-template key is no longer
+// produced by the current reconcile's ResourceAdvertisements, it must be
+// deleted like any other drifted tag, not protected forever as reserved.
+func TestGetASGTagUpdatesDropsStaleNodeTemplateTag(t *testing.T) {
+	const clusterName = "test-cluster"
+	currentTags := map[string]string{
+		eksClusterNameTag: clusterName,
+		clusterAutoscalerNodeTemplateTagPrefix + "resources/nvidia.com/gpu": "1",
+	}
+	// The user removed the GPU resource advertisement, so desired no longer
+	// includes its node-template tag.
+	desired := map[string]string{
+		eksClusterNameTag: clusterName,
+	}
+
+	tagsToDelete, _ := getASGTagUpdates(clusterName, currentTags, desired)
+
+	if _, ok := tagsToDelete[clusterAutoscalerNodeTemplateTagPrefix+"resources/nvidia.com/gpu"]; !ok {
+		t.Fatalf("expected the stale node-template tag to be queued for deletion, got tagsToDelete=%v", tagsToDelete)
+	}
+	if _, ok := tagsToDelete[eksClusterNameTag]; ok {
+		t.Fatalf("eksClusterNameTag is still reserved and must never be queued for deletion, got tagsToDelete=%v", tagsToDelete)
+	}
+}
+
+// TestGetASGTagUpdatesKeepsCurrentNodeTemplateTag ensures a node-template tag
+// that's still present in the desired set is left alone, not deleted and
+// re-added.
+func TestGetASGTagUpdatesKeepsCurrentNodeTemplateTag(t *testing.T) {
+	const clusterName = "test-cluster"
+	key := clusterAutoscalerNodeTemplateTagPrefix + "resources/nvidia.com/gpu"
+	currentTags := map[string]string{key: "1"}
+	desired := map[string]string{key: "1"}
+
+	tagsToDelete, tagsToAdd := getASGTagUpdates(clusterName, currentTags, desired)
+
+	if _, ok := tagsToDelete[key]; ok {
+		t.Fatalf("still-advertised node-template tag must not be deleted, got tagsToDelete=%v", tagsToDelete)
+	}
+	if _, ok := tagsToAdd[key]; ok {
+		t.Fatalf("unchanged node-template tag must not be re-added, got tagsToAdd=%v", tagsToAdd)
+	}
+}
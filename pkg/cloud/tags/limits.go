@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxTagsPerMutateCall is the maximum number of tags that AWS accepts in a
+	// single CreateOrUpdateTags/CreateTags/DeleteTags/TagResource/UntagResource call.
+	MaxTagsPerMutateCall = 25
+
+	// MaxResourceTags is the maximum number of tags AWS allows on an Auto Scaling
+	// group or an EC2 resource (instances, volumes, ...).
+	MaxResourceTags = 50
+
+	// batchConcurrency bounds the number of tag-mutation batches in flight at once,
+	// so we don't hammer the AWS API with dozens of concurrent requests for a single
+	// large tag set.
+	batchConcurrency = 4
+
+	// throttlingRetries is the number of times a batch is retried after being
+	// throttled by AWS before giving up.
+	throttlingRetries = 5
+
+	throttlingBaseBackoff = 200 * time.Millisecond
+)
+
+// LimitExceededError is returned when a merged tag set would exceed the number
+// of tags AWS allows on a resource, even after reserved tags have been given
+// priority over user-supplied ones.
+type LimitExceededError struct {
+	Resource    string
+	Limit       int
+	ReservedLen int
+	Additional  int
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf(
+		"%s would have %d tags (%d controller-reserved + %d user-provided AdditionalTags), which exceeds the AWS limit of %d",
+		e.Resource, e.ReservedLen+e.Additional, e.ReservedLen, e.Additional, e.Limit,
+	)
+}
+
+// TrimToLimit keeps AdditionalTags in sorted-key order, dropping the
+// highest-sorted keys, until reserved plus the surviving additional tags fit
+// within limit, giving reserved
+// (controller-managed) tags priority over user-supplied ones. It returns the
+// tag set the caller should actually apply and the additional keys that had
+// to be dropped to make room, so the caller can surface the drop on the
+// owning resource's status conditions. If reserved alone already exceeds
+// limit, additional is dropped entirely and a *LimitExceededError is still
+// returned, since no amount of trimming AdditionalTags can bring the resource
+// back under the cap.
+func TrimToLimit(resource string, limit int, reserved, additional map[string]string) (kept map[string]string, dropped []string, err error) {
+	kept = make(map[string]string, len(reserved))
+	for k, v := range reserved {
+		kept[k] = v
+	}
+
+	keys := make([]string, 0, len(additional))
+	for k := range additional {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	room := limit - len(reserved)
+	for i, k := range keys {
+		if room > 0 && i < room {
+			kept[k] = additional[k]
+			continue
+		}
+		dropped = append(dropped, k)
+	}
+
+	if len(reserved) > limit {
+		return kept, dropped, &LimitExceededError{Resource: resource, Limit: limit, ReservedLen: len(reserved), Additional: len(additional)}
+	}
+	return kept, dropped, nil
+}
+
+// ChunkStrings splits keys into batches of at most MaxTagsPerMutateCall entries,
+// preserving order.
+func ChunkStrings(keys []string) [][]string {
+	if len(keys) == 0 {
+		return nil
+	}
+	var chunks [][]string
+	for len(keys) > 0 {
+		n := MaxTagsPerMutateCall
+		if n > len(keys) {
+			n = len(keys)
+		}
+		chunks = append(chunks, keys[:n])
+		keys = keys[n:]
+	}
+	return chunks
+}
+
+// BatchMutate splits items into batches of at most MaxTagsPerMutateCall and runs
+// apply against each batch with bounded concurrency, retrying a batch a few
+// times if AWS reports it as throttled. The first non-throttling error, or the
+// first batch that is still throttled after throttlingRetries retries, is
+// returned; errors from other in-flight batches are discarded once one is
+// returned, mirroring how the rest of this package treats the first error as
+// fatal for the reconcile.
+func BatchMutate(items []string, apply func(batch []string) error) error {
+	batches := ChunkStrings(items)
+	if len(batches) == 0 {
+		return nil
+	}
+
+	sem := make(chan struct{}, batchConcurrency)
+	errCh := make(chan error, len(batches))
+
+	for _, batch := range batches {
+		batch := batch
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem }()
+			errCh <- applyWithThrottleRetry(batch, apply)
+		}()
+	}
+
+	// Drain the semaphore to ensure every goroutine above has finished.
+	for i := 0; i < batchConcurrency; i++ {
+		sem <- struct{}{}
+	}
+
+	close(errCh)
+	var firstErr error
+	for err := range errCh {
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func applyWithThrottleRetry(batch []string, apply func(batch []string) error) error {
+	var err error
+	for attempt := 0; attempt <= throttlingRetries; attempt++ {
+		err = apply(batch)
+		if err == nil || !isThrottlingError(err) {
+			return errors.Wrap(err, "failed to apply tag batch")
+		}
+		time.Sleep(throttlingBaseBackoff * time.Duration(1<<uint(attempt)))
+	}
+	return errors.Wrap(err, "failed to apply tag batch after exhausting throttling retries")
+}
+
+func isThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	switch aerr.Code() {
+	case "Throttling", "ThrottlingException", "RequestLimitExceeded", "TooManyRequestsException":
+		return true
+	default:
+		return false
+	}
+}
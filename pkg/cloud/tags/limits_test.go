@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tags
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestTrimToLimitKeepsReservedAndDropsExcessAdditional(t *testing.T) {
+	reserved := map[string]string{"eks:cluster-name": "test", "eks:nodegroup-name": "ng-1"}
+	additional := map[string]string{"a": "1", "b": "2", "c": "3"}
+
+	kept, dropped, err := TrimToLimit("Instance i-1", 3, reserved, additional)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for k := range reserved {
+		if _, ok := kept[k]; !ok {
+			t.Errorf("reserved key %q was dropped, reserved tags must always survive", k)
+		}
+	}
+	if len(kept) != 3 {
+		t.Fatalf("kept = %v, want exactly 3 tags (limit)", kept)
+	}
+	if len(dropped) != 2 {
+		t.Fatalf("dropped = %v, want 2 additional keys dropped to make room", dropped)
+	}
+	// Only one of the three additional keys fits (3 - len(reserved) == 1).
+	if _, ok := kept["a"]; !ok {
+		t.Errorf("kept = %v, want the lowest-sorted additional key \"a\" to survive", kept)
+	}
+}
+
+func TestTrimToLimitUnderLimitDropsNothing(t *testing.T) {
+	reserved := map[string]string{"eks:cluster-name": "test"}
+	additional := map[string]string{"a": "1"}
+
+	kept, dropped, err := TrimToLimit("Instance i-1", 50, reserved, additional)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(dropped) != 0 {
+		t.Fatalf("dropped = %v, want nothing dropped when well under the limit", dropped)
+	}
+	if len(kept) != 2 {
+		t.Fatalf("kept = %v, want both reserved and additional tags kept", kept)
+	}
+}
+
+func TestTrimToLimitReservedAloneOverLimitStillErrors(t *testing.T) {
+	reserved := map[string]string{"a": "1", "b": "2", "c": "3"}
+	additional := map[string]string{"d": "4"}
+
+	kept, dropped, err := TrimToLimit("Instance i-1", 2, reserved, additional)
+	if err == nil {
+		t.Fatal("expected a *LimitExceededError when reserved tags alone exceed the limit")
+	}
+	if _, ok := err.(*LimitExceededError); !ok {
+		t.Fatalf("err = %T, want *LimitExceededError", err)
+	}
+	for k := range reserved {
+		if _, ok := kept[k]; !ok {
+			t.Errorf("reserved key %q missing from kept even though reserved tags are never trimmed", k)
+		}
+	}
+	if len(dropped) != len(additional) {
+		t.Fatalf("dropped = %v, want every additional key dropped since there's no room for any", dropped)
+	}
+}
+
+func TestChunkStrings(t *testing.T) {
+	keys := make([]string, 0, 30)
+	for i := 0; i < 30; i++ {
+		keys = append(keys, fmt.Sprintf("key-%d", i))
+	}
+
+	chunks := ChunkStrings(keys)
+	if len(chunks) != 2 {
+		t.Fatalf("got %d chunks, want 2 (25 + 5) for 30 keys at MaxTagsPerMutateCall=%d", len(chunks), MaxTagsPerMutateCall)
+	}
+	if len(chunks[0]) != MaxTagsPerMutateCall {
+		t.Errorf("first chunk has %d keys, want %d", len(chunks[0]), MaxTagsPerMutateCall)
+	}
+	if len(chunks[1]) != 5 {
+		t.Errorf("second chunk has %d keys, want 5", len(chunks[1]))
+	}
+
+	if got := ChunkStrings(nil); got != nil {
+		t.Errorf("ChunkStrings(nil) = %v, want nil", got)
+	}
+}
+
+func TestBatchMutateAppliesEveryBatchAndReturnsError(t *testing.T) {
+	items := make([]string, 0, 60)
+	for i := 0; i < 60; i++ {
+		items = append(items, fmt.Sprintf("key-%d", i))
+	}
+
+	var seen int
+	var mu sync.Mutex
+	err := BatchMutate(items, func(batch []string) error {
+		mu.Lock()
+		seen += len(batch)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if seen != len(items) {
+		t.Fatalf("apply saw %d items across all batches, want %d", seen, len(items))
+	}
+
+	wantErr := fmt.Errorf("boom")
+	err = BatchMutate([]string{"a"}, func(batch []string) error {
+		return wantErr
+	})
+	if err == nil {
+		t.Fatal("expected BatchMutate to return the apply error")
+	}
+}